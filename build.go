@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hnw/rpi-sensor-exporter/sensors"
+	"github.com/tarm/serial"
+	"gobot.io/x/gobot/drivers/i2c"
+	"gobot.io/x/gobot/platforms/raspi"
+)
+
+// buildSensors connects a Raspberry Pi adaptor and constructs a Sensor for
+// every entry in cfg. A chip that fails to initialize is logged and
+// skipped rather than aborting the whole exporter, so that one flaky
+// sensor doesn't take the others down with it.
+func buildSensors(cfg *Config) ([]sensors.Sensor, error) {
+	r := raspi.NewAdaptor()
+	if err := r.Connect(); err != nil {
+		return nil, fmt.Errorf("raspi adaptor connect: %w", err)
+	}
+
+	var out []sensors.Sensor
+	for _, sc := range cfg.Sensors {
+		s, err := buildSensor(r, sc)
+		if err != nil {
+			logger.Warnw("sensor init failed",
+				"device", sc.DeviceID, "location", sc.Location, "bus", sc.Bus, "address", int(sc.Address), "error", err)
+			continue
+		}
+		logger.Infow("sensor initialized",
+			"device", sc.DeviceID, "location", sc.Location, "bus", sc.Bus, "address", int(sc.Address))
+		out = append(out, s)
+	}
+	return out, nil
+}
+
+func buildSensor(r *raspi.Adaptor, sc SensorConfig) (sensors.Sensor, error) {
+	opts := []func(i2c.Config){i2c.WithBus(sc.Bus), i2c.WithAddress(int(sc.Address))}
+
+	switch sc.Driver {
+	case "bme280":
+		d := i2c.NewBME280Driver(r, opts...)
+		if err := d.Start(); err != nil {
+			return nil, err
+		}
+		return sensors.NewBME280Sensor(d, sc.DeviceID, sc.Location), nil
+
+	case "sht2x":
+		d := i2c.NewSHT2xDriver(r, opts...)
+		if err := d.Start(); err != nil {
+			return nil, err
+		}
+		return sensors.NewSHT2xSensor(d, sc.DeviceID, sc.Location), nil
+
+	case "tsl2561":
+		if gain, _ := sc.Options["gain"].(string); gain == "16x" {
+			opts = append(opts, i2c.WithTSL2561Gain16X)
+		}
+		d := i2c.NewTSL2561Driver(r, opts...)
+		if err := d.Start(); err != nil {
+			return nil, err
+		}
+		return sensors.NewTSL2561Sensor(d, sc.DeviceID, sc.Location), nil
+
+	case "scd30":
+		return sensors.NewSCD30Sensor(r, sc.Bus, int(sc.Address), sc.DeviceID, sc.Location)
+
+	case "scd41":
+		return sensors.NewSCD41Sensor(r, sc.Bus, int(sc.Address), sc.DeviceID, sc.Location)
+
+	case "ccs811":
+		d := i2c.NewCCS811Driver(r, opts...)
+		if err := d.Start(); err != nil {
+			return nil, err
+		}
+		return sensors.NewCCS811Sensor(d, sc.DeviceID, sc.Location), nil
+
+	case "sgp30":
+		return sensors.NewSGP30Sensor(r, sc.Bus, int(sc.Address), sc.DeviceID, sc.Location)
+
+	case "sps30":
+		return sensors.NewSPS30Sensor(r, sc.Bus, int(sc.Address), sc.DeviceID, sc.Location)
+
+	case "mhz19":
+		port, err := openSerialPort(sc)
+		if err != nil {
+			return nil, err
+		}
+		return sensors.NewMHZ19Sensor(port, sc.DeviceID, sc.Location), nil
+
+	case "pms5003":
+		port, err := openSerialPort(sc)
+		if err != nil {
+			return nil, err
+		}
+		return sensors.NewPMS5003Sensor(port, sc.DeviceID, sc.Location), nil
+
+	default:
+		return nil, fmt.Errorf("unknown driver %q", sc.Driver)
+	}
+}
+
+// openSerialPort opens the UART device named by a sensor's
+// options.port/options.baud config (defaulting to 9600 baud), for drivers
+// that speak a serial protocol instead of i2c.
+func openSerialPort(sc SensorConfig) (*serial.Port, error) {
+	path, _ := sc.Options["port"].(string)
+	if path == "" {
+		return nil, fmt.Errorf("options.port is required for driver %q", sc.Driver)
+	}
+
+	baud := 9600
+	switch b := sc.Options["baud"].(type) {
+	case int:
+		baud = b
+	case float64: // a YAML float such as 9600.0 decodes as float64
+		baud = int(b)
+	}
+
+	return serial.OpenPort(&serial.Config{Name: path, Baud: baud, ReadTimeout: time.Second})
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/hnw/rpi-sensor-exporter/sinks"
+)
+
+// hexInt unmarshals YAML integers given either as a plain YAML integer
+// (119, or an unquoted 0x77 which YAML itself reads as 119) or as a hex/octal string ("0x77"), since i2c addresses are
+// conventionally written in hex.
+type hexInt int
+
+func (h *hexInt) UnmarshalYAML(value *yaml.Node) error {
+	var i int
+	if err := value.Decode(&i); err == nil {
+		*h = hexInt(i)
+		return nil
+	}
+
+	var s string
+	if err := value.Decode(&s); err != nil {
+		return fmt.Errorf("address: expected int or string, got %q", value.Value)
+	}
+
+	n, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		return fmt.Errorf("address: invalid value %q: %w", s, err)
+	}
+	*h = hexInt(n)
+	return nil
+}
+
+// SensorConfig describes a single sensor instance to attach to the exporter.
+type SensorConfig struct {
+	Driver   string                 `yaml:"driver"`
+	Bus      int                    `yaml:"bus"`
+	Address  hexInt                 `yaml:"address"`
+	Location string                 `yaml:"location"`
+	DeviceID string                 `yaml:"device_id"`
+	Options  map[string]interface{} `yaml:"options"`
+}
+
+// Config is the top-level YAML document describing the sensors attached to
+// this exporter instance and, optionally, where readings should be pushed
+// in addition to being served on /metrics.
+type Config struct {
+	Sensors   []SensorConfig         `yaml:"sensors"`
+	MQTT      *sinks.MQTTConfig      `yaml:"mqtt"`
+	InfluxDB2 *sinks.InfluxDB2Config `yaml:"influxdb2"`
+}
+
+// defaultConfig mirrors the exporter's original hard-coded sensor lineup, so
+// the exporter keeps working out of the box when no --config is given.
+func defaultConfig() *Config {
+	return &Config{
+		Sensors: []SensorConfig{
+			{Driver: "bme280", Bus: 1, Address: 0x77, Location: "indoor", DeviceID: "bme280"},
+			{Driver: "sht2x", Bus: 1, Address: 0x40, Location: "indoor", DeviceID: "sht2x"},
+			{Driver: "tsl2561", Bus: 1, Address: 0x29, Location: "indoor", DeviceID: "tsl2561",
+				Options: map[string]interface{}{"gain": "16x"}},
+		},
+	}
+}
+
+// loadConfig reads and parses the sensor configuration from path. An empty
+// path means no --config/CONFIG_FILE was given, in which case the exporter
+// falls back to defaultConfig.
+func loadConfig(path string) (*Config, error) {
+	if path == "" {
+		return defaultConfig(), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading config file: %w", err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing config file: %w", err)
+	}
+	if len(cfg.Sensors) == 0 {
+		return nil, fmt.Errorf("config file %s declares no sensors", path)
+	}
+	return &cfg, nil
+}
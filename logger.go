@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// logger is the exporter-wide structured logger, set up in main() before
+// anything else runs.
+var logger *zap.SugaredLogger
+
+// newLogger builds the exporter's logger from --log-level (debug/info/warn/error)
+// and --log-format (console/json).
+func newLogger(level, format string) (*zap.SugaredLogger, error) {
+	var lvl zapcore.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return nil, fmt.Errorf("invalid --log-level %q: %w", level, err)
+	}
+
+	var cfg zap.Config
+	switch format {
+	case "json":
+		cfg = zap.NewProductionConfig()
+	case "console":
+		cfg = zap.NewDevelopmentConfig()
+	default:
+		return nil, fmt.Errorf("invalid --log-format %q: must be \"console\" or \"json\"", format)
+	}
+	cfg.Level = zap.NewAtomicLevelAt(lvl)
+
+	l, err := cfg.Build()
+	if err != nil {
+		return nil, fmt.Errorf("building logger: %w", err)
+	}
+	return l.Sugar(), nil
+}
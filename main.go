@@ -2,184 +2,340 @@
 package main
 
 import (
-	"log"
+	"context"
+	"flag"
+	"fmt"
 	"math"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 
+	"github.com/hnw/rpi-sensor-exporter/sensors"
+	"github.com/hnw/rpi-sensor-exporter/sinks"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
-	"gobot.io/x/gobot/drivers/i2c"
-	"gobot.io/x/gobot/platforms/raspi"
 )
 
-// センサーの更新間隔
-const sensorUpdateInterval = 5 * time.Second
+const namespace = "rpi_sensor"
 
-// --- Prometheus Metrics Definitions ---
+// --- Prometheus Metric Descriptors ---
 var (
-	tempGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
-		Name: "sensor_temperature_celsius",
-		Help: "Temperature in Celsius",
-	}, []string{"device", "location"})
-
-	humGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
-		Name: "sensor_humidity_percent",
-		Help: "Relative Humidity in Percent",
-	}, []string{"device", "location"})
-
-	absHumGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
-		Name: "sensor_absolute_humidity_g_m3",
-		Help: "Absolute Humidity in g/m^3 (Calculated via Bolton's equation)",
-	}, []string{"device", "location"})
-
-	pressGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
-		Name: "sensor_pressure_hpa",
-		Help: "Pressure in hPa",
-	}, []string{"device", "location"})
-
-	luxGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
-		Name: "sensor_illuminance_lux",
-		Help: "Illuminance in Lux (Calculated)",
-	}, []string{"device", "location"})
-
-	rawIllumGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
-		Name: "sensor_light_raw",
-		Help: "Raw light sensor values",
-	}, []string{"device", "location", "type"}) // type: broadband, infrared
-)
+	tempDesc = prometheus.NewDesc(
+		"sensor_temperature_celsius", "Temperature in Celsius",
+		[]string{"device", "location"}, nil,
+	)
 
-func init() {
-	prometheus.MustRegister(
-		tempGauge, humGauge, absHumGauge, pressGauge,
-		luxGauge, rawIllumGauge,
+	humDesc = prometheus.NewDesc(
+		"sensor_humidity_percent", "Relative Humidity in Percent",
+		[]string{"device", "location"}, nil,
 	)
-}
 
-// Calculate absolute humidity(g/m^3) from temperature(C) and relative humidity(%)
-// This is based on Bolton's equation[1].
-// [1] Bolton, D., The computation of equivalent potential temperature, Monthly Weather Review, 108, 1046-1053, 1980.
-func calcAbsoluteHumidity(t float64, rh float64) (ah float64) {
-	ah = 6.112 * math.Exp(17.67*t/(t+243.5)) * rh * 2.1674 / (273.15 + t)
-	return
-}
+	absHumDesc = prometheus.NewDesc(
+		"sensor_absolute_humidity_g_m3", "Absolute Humidity in g/m^3 (Calculated via Bolton's equation)",
+		[]string{"device", "location"}, nil,
+	)
 
-func main() {
-	// 1. Initialize Adaptor (Raspberry Pi)
-	r := raspi.NewAdaptor()
+	dewPointDesc = prometheus.NewDesc(
+		"sensor_dew_point_celsius", "Dew point in Celsius (Calculated via the Magnus/Bolton approximation)",
+		[]string{"device", "location"}, nil,
+	)
+
+	frostPointDesc = prometheus.NewDesc(
+		"sensor_frost_point_celsius", "Frost point in Celsius (Calculated via Sonntag's ice-phase Magnus approximation)",
+		[]string{"device", "location"}, nil,
+	)
+
+	heatIndexDesc = prometheus.NewDesc(
+		"sensor_heat_index_celsius", "Apparent \"feels like\" temperature in Celsius (Calculated via the Rothfusz/Steadman regression)",
+		[]string{"device", "location"}, nil,
+	)
+
+	pressDesc = prometheus.NewDesc(
+		"sensor_pressure_hpa", "Pressure in hPa",
+		[]string{"device", "location"}, nil,
+	)
+
+	luxDesc = prometheus.NewDesc(
+		"sensor_illuminance_lux", "Illuminance in Lux (Calculated)",
+		[]string{"device", "location"}, nil,
+	)
+
+	rawIllumDesc = prometheus.NewDesc(
+		"sensor_light_raw", "Raw light sensor values",
+		[]string{"device", "location", "type"}, nil, // type: broadband, infrared
+	)
 
-	// 3. Initialize I2C Drivers
-	// BME280 (Default Address 0x77)
-	bme := i2c.NewBME280Driver(r)
+	co2Desc = prometheus.NewDesc(
+		"sensor_co2_ppm", "CO2 concentration in parts per million",
+		[]string{"device", "location"}, nil,
+	)
 
-	// SHT2x (Default Address 0x40)
-	sht := i2c.NewSHT2xDriver(r)
+	eco2Desc = prometheus.NewDesc(
+		"sensor_eco2_ppm", "Equivalent CO2 concentration in parts per million",
+		[]string{"device", "location"}, nil,
+	)
 
-	// TSL2561 (Address 0x29, Gain 16X)
-	tsl := i2c.NewTSL2561Driver(r, i2c.WithTSL2561Gain16X, i2c.WithAddress(0x29))
+	tvocDesc = prometheus.NewDesc(
+		"sensor_tvoc_ppb", "Total volatile organic compound concentration in parts per billion",
+		[]string{"device", "location"}, nil,
+	)
+
+	pmDesc = prometheus.NewDesc(
+		"sensor_pm_ugm3", "Particulate matter mass concentration in micrograms per cubic meter",
+		[]string{"device", "location", "size"}, nil, // size: 1.0, 2.5, 10
+	)
+
+	upDesc = prometheus.NewDesc(
+		namespace+"_up", "Whether the last read of the sensor succeeded (1) or not (0)",
+		[]string{"device"}, nil,
+	)
+
+	readDurationDesc = prometheus.NewDesc(
+		namespace+"_read_duration_seconds", "Time spent reading the sensor",
+		[]string{"device"}, nil,
+	)
 
-	// 4. Connect to Hardware
-	if err := r.Connect(); err != nil {
-		log.Fatalf("Raspberry Pi connect failed: %v", err)
+	readErrorsDesc = prometheus.NewDesc(
+		namespace+"_read_errors_total", "Number of failed reads of the sensor",
+		[]string{"device"}, nil,
+	)
+)
+
+// sensorCollector implements prometheus.Collector, reading each configured
+// sensor at scrape time instead of relying on a background update loop.
+// This avoids serving stale cached values and lets us report per-sensor
+// liveness via rpi_sensor_up. Scrapes that arrive within cacheDuration of
+// the previous one are served from the last result instead of hitting the
+// hardware again.
+type sensorCollector struct {
+	sensors []sensors.Sensor
+	sinks   []sinks.Sink
+
+	cacheDuration time.Duration
+
+	// round, if non-zero, quantizes reported measurement values to this
+	// precision (e.g. 0.25) to cut Prometheus TSDB churn on near-constant
+	// readings.
+	round float64
+
+	telemetry *telemetry
+
+	mu         sync.Mutex
+	lastScrape time.Time
+	cached     []prometheus.Metric
+
+	// readErrors accumulates each device's total failed-read count across
+	// scrapes, since rpi_sensor_read_errors_total is a counter and must
+	// report a monotonically non-decreasing value rather than just "1" on
+	// every failure.
+	readErrors map[string]uint64
+}
+
+func newSensorCollector(s []sensors.Sensor, sk []sinks.Sink, cacheDuration time.Duration, round float64, t *telemetry) *sensorCollector {
+	return &sensorCollector{sensors: s, sinks: sk, cacheDuration: cacheDuration, round: round, telemetry: t, readErrors: make(map[string]uint64)}
+}
+
+// roundTo quantizes value to the given precision (e.g. 0.25), or returns it
+// unchanged if precision is zero.
+func roundTo(value, precision float64) float64 {
+	if precision == 0 {
+		return value
 	}
+	return math.Round(value/precision) * precision
+}
+
+// Describe implements prometheus.Collector.
+func (c *sensorCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- tempDesc
+	ch <- humDesc
+	ch <- absHumDesc
+	ch <- dewPointDesc
+	ch <- frostPointDesc
+	ch <- heatIndexDesc
+	ch <- pressDesc
+	ch <- luxDesc
+	ch <- rawIllumDesc
+	ch <- co2Desc
+	ch <- eco2Desc
+	ch <- tvocDesc
+	ch <- pmDesc
+	ch <- upDesc
+	ch <- readDurationDesc
+	ch <- readErrorsDesc
+}
 
-	log.Println("Initializing sensors...")
+// Collect implements prometheus.Collector.
+func (c *sensorCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
-	// Start Sensors (Log errors but continue)
-	if err := bme.Start(); err != nil {
-		log.Printf("⚠️ BME280 init failed: %v", err)
-		bme = nil
+	if c.cacheDuration > 0 && c.cached != nil && time.Since(c.lastScrape) < c.cacheDuration {
+		for _, m := range c.cached {
+			ch <- m
+		}
+		return
 	}
-	if err := sht.Start(); err != nil {
-		log.Printf("⚠️ SHT2x init failed: %v", err)
-		sht = nil
+
+	start := time.Now()
+	var metrics []prometheus.Metric
+	for _, s := range c.sensors {
+		metrics = append(metrics, c.collectSensor(s)...)
 	}
-	if err := tsl.Start(); err != nil {
-		log.Printf("⚠️ TSL2561 init failed: %v", err)
-		tsl = nil
+	c.telemetry.scrapeDuration.Set(time.Since(start).Seconds())
+
+	c.lastScrape = time.Now()
+	c.cached = metrics
+	for _, m := range metrics {
+		ch <- m
 	}
+}
 
-	// 5. Background Update Loop (Goroutine)
-	go func() {
-		// Update immediately on start
-		updateSensors(bme, sht, tsl)
+func (c *sensorCollector) collectSensor(s sensors.Sensor) []prometheus.Metric {
+	start := time.Now()
+	readings, err := s.Read(context.Background())
+	duration := time.Since(start)
 
-		ticker := time.NewTicker(sensorUpdateInterval)
-		defer ticker.Stop()
+	metrics := []prometheus.Metric{
+		prometheus.MustNewConstMetric(readDurationDesc, prometheus.GaugeValue, duration.Seconds(), s.ID()),
+	}
 
-		for range ticker.C {
-			updateSensors(bme, sht, tsl)
+	if err != nil {
+		logger.Warnw("sensor read failed",
+			"device", s.ID(), "location", s.Location(), "error", err, "duration_ms", duration.Milliseconds())
+		c.telemetry.scrapeErrors.WithLabelValues(s.ID()).Inc()
+		c.telemetry.i2cReads.WithLabelValues(s.ID(), "error").Inc()
+		c.readErrors[s.ID()]++
+		return append(metrics,
+			prometheus.MustNewConstMetric(upDesc, prometheus.GaugeValue, 0, s.ID()),
+			prometheus.MustNewConstMetric(readErrorsDesc, prometheus.CounterValue, float64(c.readErrors[s.ID()]), s.ID()),
+		)
+	}
+
+	c.telemetry.i2cReads.WithLabelValues(s.ID(), "success").Inc()
+	logger.Debugw("sensor read",
+		"device", s.ID(), "location", s.Location(), "duration_ms", duration.Milliseconds())
+
+	metrics = append(metrics,
+		prometheus.MustNewConstMetric(upDesc, prometheus.GaugeValue, 1, s.ID()),
+		prometheus.MustNewConstMetric(readErrorsDesc, prometheus.CounterValue, float64(c.readErrors[s.ID()]), s.ID()),
+	)
+	for _, m := range readings {
+		m.Value = roundTo(m.Value, c.round)
+		if metric, ok := measurementMetric(m, s.ID(), s.Location()); ok {
+			metrics = append(metrics, metric)
 		}
-	}()
+	}
 
-	// 6. HTTP Handler
-	http.Handle("/metrics", promhttp.Handler())
-	port := getEnv("PORT", "9101")
-	log.Println("rpi-sensor-exporter listening on :" + port)
+	c.publish(s, readings)
+	return metrics
+}
 
-	server := &http.Server{
-		Addr:              ":" + port,
-		ReadHeaderTimeout: 3 * time.Second,
+// publish pushes a sensor's readings to every configured sink, logging
+// (but not failing the scrape on) any publish error.
+func (c *sensorCollector) publish(s sensors.Sensor, readings []sensors.Measurement) {
+	for _, sink := range c.sinks {
+		if err := sink.Publish(context.Background(), s.ID(), s.Location(), readings); err != nil {
+			logger.Warnw("sink publish failed", "device", s.ID(), "location", s.Location(), "error", err)
+		}
 	}
+}
 
-	if err := server.ListenAndServe(); err != nil {
-		log.Fatal(err)
+// measurementMetric maps a driver-reported Measurement onto its exported
+// Prometheus metric.
+func measurementMetric(m sensors.Measurement, device, location string) (prometheus.Metric, bool) {
+	switch m.Name {
+	case sensors.Temperature:
+		return prometheus.MustNewConstMetric(tempDesc, prometheus.GaugeValue, m.Value, device, location), true
+	case sensors.Humidity:
+		return prometheus.MustNewConstMetric(humDesc, prometheus.GaugeValue, m.Value, device, location), true
+	case sensors.AbsoluteHumidity:
+		return prometheus.MustNewConstMetric(absHumDesc, prometheus.GaugeValue, m.Value, device, location), true
+	case sensors.DewPoint:
+		return prometheus.MustNewConstMetric(dewPointDesc, prometheus.GaugeValue, m.Value, device, location), true
+	case sensors.FrostPoint:
+		return prometheus.MustNewConstMetric(frostPointDesc, prometheus.GaugeValue, m.Value, device, location), true
+	case sensors.HeatIndex:
+		return prometheus.MustNewConstMetric(heatIndexDesc, prometheus.GaugeValue, m.Value, device, location), true
+	case sensors.Pressure:
+		return prometheus.MustNewConstMetric(pressDesc, prometheus.GaugeValue, m.Value, device, location), true
+	case sensors.Illuminance:
+		return prometheus.MustNewConstMetric(luxDesc, prometheus.GaugeValue, m.Value, device, location), true
+	case sensors.RawBroadband:
+		return prometheus.MustNewConstMetric(rawIllumDesc, prometheus.GaugeValue, m.Value, device, location, "broadband"), true
+	case sensors.RawInfrared:
+		return prometheus.MustNewConstMetric(rawIllumDesc, prometheus.GaugeValue, m.Value, device, location, "infrared"), true
+	case sensors.CO2:
+		return prometheus.MustNewConstMetric(co2Desc, prometheus.GaugeValue, m.Value, device, location), true
+	case sensors.ECO2:
+		return prometheus.MustNewConstMetric(eco2Desc, prometheus.GaugeValue, m.Value, device, location), true
+	case sensors.TVOC:
+		return prometheus.MustNewConstMetric(tvocDesc, prometheus.GaugeValue, m.Value, device, location), true
+	case sensors.PM1_0:
+		return prometheus.MustNewConstMetric(pmDesc, prometheus.GaugeValue, m.Value, device, location, "1.0"), true
+	case sensors.PM2_5:
+		return prometheus.MustNewConstMetric(pmDesc, prometheus.GaugeValue, m.Value, device, location, "2.5"), true
+	case sensors.PM10:
+		return prometheus.MustNewConstMetric(pmDesc, prometheus.GaugeValue, m.Value, device, location, "10"), true
+	default:
+		logger.Warnw("ignoring unknown measurement", "device", device, "measurement", m.Name)
+		return nil, false
 	}
 }
 
-func updateSensors(
-	bme *i2c.BME280Driver, sht *i2c.SHT2xDriver, tsl *i2c.TSL2561Driver,
-) {
-	// --- BME280 ---
-	if bme != nil {
-		t, errT := bme.Temperature()
-		p, errP := bme.Pressure()
-		h, errH := bme.Humidity()
-
-		if errT != nil || errP != nil || errH != nil {
-			log.Printf("BME280 read error: T=%v, P=%v, H=%v", errT, errP, errH)
-		} else {
-			tempGauge.WithLabelValues("bme280", "indoor").Set(float64(t))
-			pressGauge.WithLabelValues("bme280", "indoor").Set(float64(p) / 100.0) // Pa -> hPa
-			humGauge.WithLabelValues("bme280", "indoor").Set(float64(h))
-
-			// Calc Absolute Humidity
-			ah := calcAbsoluteHumidity(float64(t), float64(h))
-			absHumGauge.WithLabelValues("bme280", "indoor").Set(ah)
-		}
+func main() {
+	cacheDuration := flag.Duration("cache-duration", 2*time.Second, "coalesce scrapes arriving within this interval of each other instead of re-reading the sensors")
+	configPath := flag.String("config", getEnv("CONFIG_FILE", ""), "path to the YAML config describing attached sensors (defaults to a single BME280/SHT2x/TSL2561 lineup)")
+	logLevel := flag.String("log-level", "info", "minimum log level: debug, info, warn, or error")
+	logFormat := flag.String("log-format", "console", "log output format: console or json")
+	round := flag.Float64("round", 0, "quantize reported measurement values to this precision (e.g. 0.25) to reduce Prometheus TSDB churn; 0 disables rounding")
+	flag.Parse()
+
+	var err error
+	logger, err = newLogger(*logLevel, *logFormat)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "rpi-sensor-exporter: %v\n", err)
+		os.Exit(1)
 	}
+	defer logger.Sync() //nolint:errcheck
 
-	// --- SHT2x ---
-	if sht != nil {
-		t, errT := sht.Temperature()
-		h, errH := sht.Humidity()
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		logger.Fatalw("loading config", "error", err)
+	}
 
-		if errT != nil || errH != nil {
-			log.Printf("SHT2x read error: T=%v, H=%v", errT, errH)
-		} else {
-			tempGauge.WithLabelValues("sht2x", "indoor").Set(float64(t))
-			humGauge.WithLabelValues("sht2x", "indoor").Set(float64(h))
+	logger.Infow("initializing sensors")
+	sensorList, err := buildSensors(cfg)
+	if err != nil {
+		logger.Fatalw("initializing sensors", "error", err)
+	}
 
-			// Calc Absolute Humidity
-			ah := calcAbsoluteHumidity(float64(t), float64(h))
-			absHumGauge.WithLabelValues("sht2x", "indoor").Set(ah)
-		}
+	sinkList, err := buildSinks(cfg)
+	if err != nil {
+		logger.Fatalw("initializing sinks", "error", err)
 	}
 
-	// --- TSL2561 ---
-	if tsl != nil {
-		bb, ir, err := tsl.GetLuminocity()
-		if err != nil {
-			log.Printf("TSL2561 read error: %v", err)
-		} else {
-			// Calculate Lux using Gobot's internal helper
-			lux := tsl.CalculateLux(bb, ir)
+	// Sensor metrics and exporter self-telemetry live on separate registries
+	// so that exporter health stays observable even when every sensor read
+	// is failing, then get merged for /metrics.
+	sensorRegistry := prometheus.NewRegistry()
+	telemetryRegistry := prometheus.NewRegistry()
+	tel := newTelemetry(telemetryRegistry)
+	sensorRegistry.MustRegister(newSensorCollector(sensorList, sinkList, *cacheDuration, *round, tel))
+
+	// HTTP Handler
+	gatherers := prometheus.Gatherers{sensorRegistry, telemetryRegistry}
+	http.Handle("/metrics", promhttp.HandlerFor(gatherers, promhttp.HandlerOpts{}))
+	port := getEnv("PORT", "9101")
+	logger.Infow("rpi-sensor-exporter listening", "port", port)
 
-			luxGauge.WithLabelValues("tsl2561", "indoor").Set(float64(lux))
-			rawIllumGauge.WithLabelValues("tsl2561", "indoor", "broadband").Set(float64(bb))
-			rawIllumGauge.WithLabelValues("tsl2561", "indoor", "infrared").Set(float64(ir))
-		}
+	server := &http.Server{
+		Addr:              ":" + port,
+		ReadHeaderTimeout: 3 * time.Second,
+	}
+
+	if err := server.ListenAndServe(); err != nil {
+		logger.Fatalw("http server stopped", "error", err)
 	}
 }
 
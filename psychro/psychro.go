@@ -0,0 +1,59 @@
+// Package psychro implements small psychrometric calculations derived from
+// temperature and relative humidity readings: absolute humidity, dew
+// point, frost point, and heat index.
+package psychro
+
+import "math"
+
+// AbsoluteHumidity derives absolute humidity (g/m^3) from temperature (C)
+// and relative humidity (%) via Bolton's equation[1].
+// [1] Bolton, D., The computation of equivalent potential temperature, Monthly Weather Review, 108, 1046-1053, 1980.
+func AbsoluteHumidity(t, rh float64) float64 {
+	return 6.112 * math.Exp(17.67*t/(t+243.5)) * rh * 2.1674 / (273.15 + t)
+}
+
+// vaporPressure returns the actual vapor pressure (hPa) for temperature t
+// (C) and relative humidity rh (%), via the Magnus/Bolton approximation.
+func vaporPressure(t, rh float64) float64 {
+	return 6.112 * math.Exp(17.67*t/(t+243.5)) * rh / 100
+}
+
+// DewPoint derives the dew point (C) from temperature (C) and relative
+// humidity (%) by inverting the Magnus/Bolton approximation:
+// Td = 243.5 * ln(e/6.112) / (17.67 - ln(e/6.112))
+func DewPoint(t, rh float64) float64 {
+	lnE := math.Log(vaporPressure(t, rh) / 6.112)
+	return 243.5 * lnE / (17.67 - lnE)
+}
+
+// FrostPoint derives the frost point (C) - the sublimation-point analogue
+// of dew point, meaningful once the air is below freezing - from
+// temperature (C) and relative humidity (%). It inverts the Magnus
+// approximation over ice using Sonntag's (1990) ice-phase coefficients.
+func FrostPoint(t, rh float64) float64 {
+	lnE := math.Log(vaporPressure(t, rh) / 6.112)
+	return 272.62 * lnE / (22.46 - lnE)
+}
+
+// HeatIndex derives the apparent "feels like" temperature (C) from air
+// temperature (C) and relative humidity (%) via NOAA's heat index
+// algorithm[1], which is defined in degrees Fahrenheit. Below 80F the full
+// Rothfusz/Steadman regression is not valid (it can report the air feeling
+// hotter than it actually is at ordinary indoor conditions), so NOAA's
+// simpler average formula is used instead; the full regression only
+// applies once that average already indicates a hot day.
+// [1] Rothfusz, L.P., The heat index equation, NWS Technical Attachment SR 90-23, 1990.
+func HeatIndex(t, rh float64) float64 {
+	tf := t*9/5 + 32
+
+	avgF := 0.5 * (tf + 61 + (tf-68)*1.2 + rh*0.094)
+	if avgF < 80 {
+		return (avgF - 32) * 5 / 9
+	}
+
+	hiF := -42.379 + 2.04901523*tf + 10.14333127*rh -
+		0.22475541*tf*rh - 0.00683783*tf*tf - 0.05481717*rh*rh +
+		0.00122874*tf*tf*rh + 0.00085282*tf*rh*rh - 0.00000199*tf*tf*rh*rh
+
+	return (hiF - 32) * 5 / 9
+}
@@ -0,0 +1,61 @@
+package psychro
+
+import (
+	"math"
+	"testing"
+)
+
+func approxEqual(a, b, tol float64) bool {
+	return math.Abs(a-b) <= tol
+}
+
+func TestAbsoluteHumidity(t *testing.T) {
+	// 20C/50% RH is a commonly cited reference point (~8.65 g/m^3).
+	if got := AbsoluteHumidity(20, 50); !approxEqual(got, 8.65, 0.1) {
+		t.Errorf("AbsoluteHumidity(20, 50) = %v, want ~8.65", got)
+	}
+}
+
+func TestDewPoint(t *testing.T) {
+	cases := []struct {
+		t, rh, want, tol float64
+	}{
+		{20, 50, 9.3, 0.2},
+		{30, 80, 26.2, 0.2},
+		{0, 100, 0, 0.2},
+	}
+	for _, c := range cases {
+		if got := DewPoint(c.t, c.rh); !approxEqual(got, c.want, c.tol) {
+			t.Errorf("DewPoint(%v, %v) = %v, want ~%v", c.t, c.rh, got, c.want)
+		}
+	}
+}
+
+func TestFrostPoint(t *testing.T) {
+	cases := []struct {
+		t, rh, want, tol float64
+	}{
+		{-10, 100, -8.9, 0.1},
+		{-5, 80, -7.0, 0.1},
+	}
+	for _, c := range cases {
+		if got := FrostPoint(c.t, c.rh); !approxEqual(got, c.want, c.tol) {
+			t.Errorf("FrostPoint(%v, %v) = %v, want ~%v", c.t, c.rh, got, c.want)
+		}
+	}
+}
+
+func TestHeatIndex(t *testing.T) {
+	// Ordinary indoor conditions: the "feels like" temperature must not
+	// exceed the actual air temperature at moderate humidity.
+	if got := HeatIndex(20, 50); got > 20 {
+		t.Errorf("HeatIndex(20, 50) = %v, want <= 20 (indoor reading should not feel hotter than actual)", got)
+	}
+
+	// A classic hot/humid NOAA worked example (90F/70%RH ~ 105F), falling
+	// into the full Rothfusz regression.
+	want := (105.0 - 32) * 5 / 9
+	if got := HeatIndex((90.0-32)*5/9, 70); !approxEqual(got, want, 2) {
+		t.Errorf("HeatIndex(32.2, 70) = %v, want ~%v", got, want)
+	}
+}
@@ -0,0 +1,53 @@
+package sensors
+
+import (
+	"context"
+
+	"gobot.io/x/gobot/drivers/i2c"
+
+	"github.com/hnw/rpi-sensor-exporter/psychro"
+)
+
+// BME280Sensor reads temperature, pressure and humidity from a Bosch BME280
+// via gobot's i2c driver.
+type BME280Sensor struct {
+	driver   *i2c.BME280Driver
+	id       string
+	location string
+}
+
+// NewBME280Sensor wraps an already-started BME280 driver as a Sensor.
+func NewBME280Sensor(driver *i2c.BME280Driver, id, location string) *BME280Sensor {
+	return &BME280Sensor{driver: driver, id: id, location: location}
+}
+
+func (s *BME280Sensor) ID() string       { return s.id }
+func (s *BME280Sensor) Location() string { return s.location }
+func (s *BME280Sensor) Close() error     { return s.driver.Halt() }
+
+// Read implements Sensor.
+func (s *BME280Sensor) Read(ctx context.Context) ([]Measurement, error) {
+	t, err := s.driver.Temperature()
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.driver.Pressure()
+	if err != nil {
+		return nil, err
+	}
+	h, err := s.driver.Humidity()
+	if err != nil {
+		return nil, err
+	}
+
+	tf, hf := float64(t), float64(h)
+	return []Measurement{
+		{Name: Temperature, Value: tf, Unit: "celsius"},
+		{Name: Pressure, Value: float64(p) / 100.0, Unit: "hpa"}, // Pa -> hPa
+		{Name: Humidity, Value: hf, Unit: "percent"},
+		{Name: AbsoluteHumidity, Value: psychro.AbsoluteHumidity(tf, hf), Unit: "g_m3"},
+		{Name: DewPoint, Value: psychro.DewPoint(tf, hf), Unit: "celsius"},
+		{Name: FrostPoint, Value: psychro.FrostPoint(tf, hf), Unit: "celsius"},
+		{Name: HeatIndex, Value: psychro.HeatIndex(tf, hf), Unit: "celsius"},
+	}, nil
+}
@@ -0,0 +1,37 @@
+package sensors
+
+import (
+	"context"
+
+	"gobot.io/x/gobot/drivers/i2c"
+)
+
+// CCS811Sensor reads eCO2 and TVOC from an ams CCS811 via gobot's i2c
+// driver.
+type CCS811Sensor struct {
+	driver   *i2c.CCS811Driver
+	id       string
+	location string
+}
+
+// NewCCS811Sensor wraps an already-started CCS811 driver as a Sensor.
+func NewCCS811Sensor(driver *i2c.CCS811Driver, id, location string) *CCS811Sensor {
+	return &CCS811Sensor{driver: driver, id: id, location: location}
+}
+
+func (s *CCS811Sensor) ID() string       { return s.id }
+func (s *CCS811Sensor) Location() string { return s.location }
+func (s *CCS811Sensor) Close() error     { return s.driver.Halt() }
+
+// Read implements Sensor.
+func (s *CCS811Sensor) Read(ctx context.Context) ([]Measurement, error) {
+	eco2, tvoc, err := s.driver.GetGasData()
+	if err != nil {
+		return nil, err
+	}
+
+	return []Measurement{
+		{Name: ECO2, Value: float64(eco2), Unit: "ppm"},
+		{Name: TVOC, Value: float64(tvoc), Unit: "ppb"},
+	}, nil
+}
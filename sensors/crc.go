@@ -0,0 +1,20 @@
+package sensors
+
+// sensirionCRC8 implements the CRC-8 checksum (poly 0x31, init 0xFF) that
+// Sensirion chips (SCD30, SCD41, SGP30, SPS30, ...) append to every 2-byte
+// word on the wire.
+func sensirionCRC8(data []byte) byte {
+	const poly = 0x31
+	crc := byte(0xFF)
+	for _, b := range data {
+		crc ^= b
+		for i := 0; i < 8; i++ {
+			if crc&0x80 != 0 {
+				crc = (crc << 1) ^ poly
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
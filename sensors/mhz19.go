@@ -0,0 +1,59 @@
+package sensors
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// mhz19ReadCO2 is the "Read CO2 Concentration" command frame for the
+// Winsen MH-Z19(B), including its trailing checksum byte.
+var mhz19ReadCO2 = []byte{0xFF, 0x01, 0x86, 0x00, 0x00, 0x00, 0x00, 0x00, 0x79}
+
+// MHZ19Sensor reads CO2 concentration from a Winsen MH-Z19 over its UART
+// protocol.
+type MHZ19Sensor struct {
+	port     UARTPort
+	id       string
+	location string
+}
+
+// NewMHZ19Sensor wraps an already-open UART connection to an MH-Z19.
+func NewMHZ19Sensor(port UARTPort, id, location string) *MHZ19Sensor {
+	return &MHZ19Sensor{port: port, id: id, location: location}
+}
+
+func (s *MHZ19Sensor) ID() string       { return s.id }
+func (s *MHZ19Sensor) Location() string { return s.location }
+func (s *MHZ19Sensor) Close() error     { return nil }
+
+// Read implements Sensor.
+func (s *MHZ19Sensor) Read(ctx context.Context) ([]Measurement, error) {
+	if _, err := s.port.Write(mhz19ReadCO2); err != nil {
+		return nil, fmt.Errorf("mhz19: writing command: %w", err)
+	}
+
+	resp := make([]byte, 9)
+	if _, err := io.ReadFull(s.port, resp); err != nil {
+		return nil, fmt.Errorf("mhz19: reading response: %w", err)
+	}
+	if resp[0] != 0xFF || resp[1] != 0x86 {
+		return nil, fmt.Errorf("mhz19: unexpected response header % x", resp[:2])
+	}
+	if chk := mhz19Checksum(resp); chk != resp[8] {
+		return nil, fmt.Errorf("mhz19: checksum mismatch: got %#x want %#x", resp[8], chk)
+	}
+
+	co2 := int(resp[2])*256 + int(resp[3])
+	return []Measurement{
+		{Name: CO2, Value: float64(co2), Unit: "ppm"},
+	}, nil
+}
+
+func mhz19Checksum(frame []byte) byte {
+	var sum byte
+	for _, b := range frame[1:8] {
+		sum += b
+	}
+	return 0xFF - sum + 1
+}
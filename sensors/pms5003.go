@@ -0,0 +1,62 @@
+package sensors
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// pms5003ReadPassive requests one measurement from a PMS5003 running in
+// passive mode.
+var pms5003ReadPassive = []byte{0x42, 0x4D, 0xE2, 0x00, 0x00, 0x01, 0x71}
+
+// PMS5003Sensor reads PM1.0, PM2.5 and PM10 atmospheric concentrations
+// from a Plantower PMS5003 over its UART protocol.
+type PMS5003Sensor struct {
+	port     UARTPort
+	id       string
+	location string
+}
+
+// NewPMS5003Sensor wraps an already-open UART connection to a PMS5003
+// that has been configured for passive (on-demand) reads.
+func NewPMS5003Sensor(port UARTPort, id, location string) *PMS5003Sensor {
+	return &PMS5003Sensor{port: port, id: id, location: location}
+}
+
+func (s *PMS5003Sensor) ID() string       { return s.id }
+func (s *PMS5003Sensor) Location() string { return s.location }
+func (s *PMS5003Sensor) Close() error     { return nil }
+
+// Read implements Sensor.
+func (s *PMS5003Sensor) Read(ctx context.Context) ([]Measurement, error) {
+	if _, err := s.port.Write(pms5003ReadPassive); err != nil {
+		return nil, fmt.Errorf("pms5003: writing read request: %w", err)
+	}
+
+	frame := make([]byte, 32)
+	if _, err := io.ReadFull(s.port, frame); err != nil {
+		return nil, fmt.Errorf("pms5003: reading frame: %w", err)
+	}
+	if frame[0] != 0x42 || frame[1] != 0x4D {
+		return nil, fmt.Errorf("pms5003: unexpected frame header % x", frame[:2])
+	}
+
+	var checksum uint16
+	for _, b := range frame[:30] {
+		checksum += uint16(b)
+	}
+	if got := uint16(frame[30])<<8 | uint16(frame[31]); got != checksum {
+		return nil, fmt.Errorf("pms5003: checksum mismatch: got %#x want %#x", got, checksum)
+	}
+
+	pm1_0 := uint16(frame[10])<<8 | uint16(frame[11])
+	pm2_5 := uint16(frame[12])<<8 | uint16(frame[13])
+	pm10 := uint16(frame[14])<<8 | uint16(frame[15])
+
+	return []Measurement{
+		{Name: PM1_0, Value: float64(pm1_0), Unit: "ug_m3"},
+		{Name: PM2_5, Value: float64(pm2_5), Unit: "ug_m3"},
+		{Name: PM10, Value: float64(pm10), Unit: "ug_m3"},
+	}, nil
+}
@@ -0,0 +1,70 @@
+package sensors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gobot.io/x/gobot/drivers/i2c"
+)
+
+// scd30ReadMeasurement is the "Read Measurement" command (0x0300) from the
+// Sensirion SCD30 interface description.
+var scd30ReadMeasurement = []byte{0x03, 0x00}
+
+// SCD30Sensor reads CO2, temperature and humidity from a Sensirion SCD30
+// over i2c. Unlike gobot's register-addressed drivers, Sensirion's i2c
+// sensors speak in raw 16-bit commands and CRC-checked word replies, so
+// this talks to the adaptor's raw i2c.Connection directly.
+type SCD30Sensor struct {
+	conn     i2c.Connection
+	id       string
+	location string
+}
+
+// NewSCD30Sensor opens an i2c connection to an SCD30 at address/bus and
+// assumes continuous measurement has already been started on the chip
+// (e.g. via a prior "Trigger Continuous Measurement" command).
+func NewSCD30Sensor(adaptor i2c.Connector, bus, address int, id, location string) (*SCD30Sensor, error) {
+	conn, err := adaptor.GetConnection(address, bus)
+	if err != nil {
+		return nil, fmt.Errorf("scd30: opening i2c connection: %w", err)
+	}
+	return &SCD30Sensor{conn: conn, id: id, location: location}, nil
+}
+
+func (s *SCD30Sensor) ID() string       { return s.id }
+func (s *SCD30Sensor) Location() string { return s.location }
+func (s *SCD30Sensor) Close() error     { return s.conn.Close() }
+
+// Read implements Sensor.
+func (s *SCD30Sensor) Read(ctx context.Context) ([]Measurement, error) {
+	if _, err := s.conn.Write(scd30ReadMeasurement); err != nil {
+		return nil, fmt.Errorf("scd30: writing read command: %w", err)
+	}
+	time.Sleep(3 * time.Millisecond) // per datasheet: command execution time
+
+	buf := make([]byte, 18)
+	if _, err := s.conn.Read(buf); err != nil {
+		return nil, fmt.Errorf("scd30: reading measurement: %w", err)
+	}
+
+	co2, err := decodeSensirionFloat32(buf[0:6])
+	if err != nil {
+		return nil, fmt.Errorf("scd30: co2: %w", err)
+	}
+	temp, err := decodeSensirionFloat32(buf[6:12])
+	if err != nil {
+		return nil, fmt.Errorf("scd30: temperature: %w", err)
+	}
+	rh, err := decodeSensirionFloat32(buf[12:18])
+	if err != nil {
+		return nil, fmt.Errorf("scd30: humidity: %w", err)
+	}
+
+	return []Measurement{
+		{Name: CO2, Value: float64(co2), Unit: "ppm"},
+		{Name: Temperature, Value: float64(temp), Unit: "celsius"},
+		{Name: Humidity, Value: float64(rh), Unit: "percent"},
+	}, nil
+}
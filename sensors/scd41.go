@@ -0,0 +1,70 @@
+package sensors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gobot.io/x/gobot/drivers/i2c"
+)
+
+// scd41ReadMeasurement is the "Read Measurement" command (0xEC05) from the
+// Sensirion SCD41 datasheet.
+var scd41ReadMeasurement = []byte{0xEC, 0x05}
+
+// SCD41Sensor reads CO2, temperature and humidity from a Sensirion SCD41
+// over i2c, assuming periodic measurement has already been started on the
+// chip.
+type SCD41Sensor struct {
+	conn     i2c.Connection
+	id       string
+	location string
+}
+
+// NewSCD41Sensor opens an i2c connection to an SCD41 at address/bus.
+func NewSCD41Sensor(adaptor i2c.Connector, bus, address int, id, location string) (*SCD41Sensor, error) {
+	conn, err := adaptor.GetConnection(address, bus)
+	if err != nil {
+		return nil, fmt.Errorf("scd41: opening i2c connection: %w", err)
+	}
+	return &SCD41Sensor{conn: conn, id: id, location: location}, nil
+}
+
+func (s *SCD41Sensor) ID() string       { return s.id }
+func (s *SCD41Sensor) Location() string { return s.location }
+func (s *SCD41Sensor) Close() error     { return s.conn.Close() }
+
+// Read implements Sensor.
+func (s *SCD41Sensor) Read(ctx context.Context) ([]Measurement, error) {
+	if _, err := s.conn.Write(scd41ReadMeasurement); err != nil {
+		return nil, fmt.Errorf("scd41: writing read command: %w", err)
+	}
+	time.Sleep(1 * time.Millisecond) // per datasheet: command execution time
+
+	buf := make([]byte, 9)
+	if _, err := s.conn.Read(buf); err != nil {
+		return nil, fmt.Errorf("scd41: reading measurement: %w", err)
+	}
+
+	co2, err := decodeSensirionUint16(buf[0:3])
+	if err != nil {
+		return nil, fmt.Errorf("scd41: co2: %w", err)
+	}
+	rawTemp, err := decodeSensirionUint16(buf[3:6])
+	if err != nil {
+		return nil, fmt.Errorf("scd41: temperature: %w", err)
+	}
+	rawRH, err := decodeSensirionUint16(buf[6:9])
+	if err != nil {
+		return nil, fmt.Errorf("scd41: humidity: %w", err)
+	}
+
+	temp := -45 + 175*float64(rawTemp)/65536
+	rh := 100 * float64(rawRH) / 65536
+
+	return []Measurement{
+		{Name: CO2, Value: float64(co2), Unit: "ppm"},
+		{Name: Temperature, Value: temp, Unit: "celsius"},
+		{Name: Humidity, Value: rh, Unit: "percent"},
+	}, nil
+}
@@ -0,0 +1,34 @@
+package sensors
+
+import (
+	"fmt"
+	"math"
+)
+
+// decodeSensirionFloat32 decodes a big-endian IEEE-754 float32 sent as two
+// CRC-checked 16-bit words, the wire format used by SCD30/SPS30 for
+// measurement values: [hiMSB, hiLSB, hiCRC, loMSB, loLSB, loCRC].
+func decodeSensirionFloat32(b []byte) (float32, error) {
+	if len(b) != 6 {
+		return 0, fmt.Errorf("sensirion float32: expected 6 bytes, got %d", len(b))
+	}
+	if sensirionCRC8(b[0:2]) != b[2] {
+		return 0, fmt.Errorf("sensirion float32: crc mismatch in high word")
+	}
+	if sensirionCRC8(b[3:5]) != b[5] {
+		return 0, fmt.Errorf("sensirion float32: crc mismatch in low word")
+	}
+	bits := uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[3])<<8 | uint32(b[4])
+	return math.Float32frombits(bits), nil
+}
+
+// decodeSensirionUint16 decodes a single CRC-checked 16-bit word: [MSB, LSB, CRC].
+func decodeSensirionUint16(b []byte) (uint16, error) {
+	if len(b) != 3 {
+		return 0, fmt.Errorf("sensirion uint16: expected 3 bytes, got %d", len(b))
+	}
+	if sensirionCRC8(b[0:2]) != b[2] {
+		return 0, fmt.Errorf("sensirion uint16: crc mismatch")
+	}
+	return uint16(b[0])<<8 | uint16(b[1]), nil
+}
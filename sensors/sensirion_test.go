@@ -0,0 +1,61 @@
+package sensors
+
+import "testing"
+
+func TestSensirionCRC8(t *testing.T) {
+	cases := []struct {
+		name string
+		data []byte
+		want byte
+	}{
+		// 0xBE 0xEF -> 0x92 is Sensirion's own published worked example.
+		{"datasheet example", []byte{0xBE, 0xEF}, 0x92},
+		{"zeros", []byte{0x00, 0x00}, 0x81},
+		{"ones", []byte{0xFF, 0xFF}, 0xAC},
+	}
+	for _, c := range cases {
+		if got := sensirionCRC8(c.data); got != c.want {
+			t.Errorf("%s: sensirionCRC8(%x) = %#x, want %#x", c.name, c.data, got, c.want)
+		}
+	}
+}
+
+func TestDecodeSensirionUint16(t *testing.T) {
+	got, err := decodeSensirionUint16([]byte{0xBE, 0xEF, 0x92})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := uint16(0xBEEF); got != want {
+		t.Errorf("got %#x, want %#x", got, want)
+	}
+
+	if _, err := decodeSensirionUint16([]byte{0xBE, 0xEF, 0x00}); err == nil {
+		t.Error("expected crc mismatch error, got nil")
+	}
+
+	if _, err := decodeSensirionUint16([]byte{0xBE, 0xEF}); err == nil {
+		t.Error("expected length error, got nil")
+	}
+}
+
+func TestDecodeSensirionFloat32(t *testing.T) {
+	// 25.5 as big-endian IEEE754 is 0x41CC0000, split into two CRC-checked words.
+	b := []byte{0x41, 0xCC, sensirionCRC8([]byte{0x41, 0xCC}), 0x00, 0x00, sensirionCRC8([]byte{0x00, 0x00})}
+	got, err := decodeSensirionFloat32(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := float32(25.5); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	bad := append([]byte(nil), b...)
+	bad[2] ^= 0xFF
+	if _, err := decodeSensirionFloat32(bad); err == nil {
+		t.Error("expected crc mismatch error in high word, got nil")
+	}
+
+	if _, err := decodeSensirionFloat32(b[:5]); err == nil {
+		t.Error("expected length error, got nil")
+	}
+}
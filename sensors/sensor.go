@@ -0,0 +1,51 @@
+// Package sensors defines the driver interface used to read environmental
+// measurements, independent of the transport (i2c, UART, ...) or metric
+// exporter wired up on top of it.
+package sensors
+
+import (
+	"context"
+)
+
+// Well-known measurement names. A driver may report any subset of these
+// depending on what the chip actually measures; the exporter maps each
+// name to the matching Prometheus metric.
+const (
+	Temperature      = "temperature"
+	Humidity         = "humidity"
+	AbsoluteHumidity = "absolute_humidity"
+	DewPoint         = "dew_point"
+	FrostPoint       = "frost_point"
+	HeatIndex        = "heat_index"
+	Pressure         = "pressure"
+	Illuminance      = "illuminance"
+	RawBroadband     = "raw_broadband"
+	RawInfrared      = "raw_infrared"
+	CO2              = "co2"
+	ECO2             = "eco2"
+	TVOC             = "tvoc"
+	PM1_0            = "pm1_0"
+	PM2_5            = "pm2_5"
+	PM10             = "pm10"
+)
+
+// Measurement is a single named reading produced by a Sensor.
+type Measurement struct {
+	Name  string
+	Value float64
+	Unit  string
+}
+
+// Sensor is implemented by every sensor driver the exporter knows how to
+// read, whatever its transport.
+type Sensor interface {
+	// ID is the unique device identifier used to label metrics.
+	ID() string
+	// Location is the room/zone label this sensor was configured with.
+	Location() string
+	// Read takes one reading from the sensor.
+	Read(ctx context.Context) ([]Measurement, error)
+	// Close releases any resources held by the sensor (e.g. i2c
+	// connections). UART sensors leave their port to whoever opened it.
+	Close() error
+}
@@ -0,0 +1,88 @@
+package sensors
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"gobot.io/x/gobot/drivers/i2c"
+)
+
+var (
+	sgp30CmdInitAirQuality    = []byte{0x20, 0x03}
+	sgp30CmdMeasureAirQuality = []byte{0x20, 0x08}
+)
+
+// SGP30Sensor reads eCO2 and TVOC from a Sensirion SGP30 over i2c.
+type SGP30Sensor struct {
+	conn     i2c.Connection
+	id       string
+	location string
+
+	mu       sync.Mutex
+	initDone bool
+}
+
+// NewSGP30Sensor opens an i2c connection to an SGP30 at address/bus.
+func NewSGP30Sensor(adaptor i2c.Connector, bus, address int, id, location string) (*SGP30Sensor, error) {
+	conn, err := adaptor.GetConnection(address, bus)
+	if err != nil {
+		return nil, fmt.Errorf("sgp30: opening i2c connection: %w", err)
+	}
+	return &SGP30Sensor{conn: conn, id: id, location: location}, nil
+}
+
+func (s *SGP30Sensor) ID() string       { return s.id }
+func (s *SGP30Sensor) Location() string { return s.location }
+func (s *SGP30Sensor) Close() error     { return s.conn.Close() }
+
+// init runs the chip's mandatory "Init Air Quality" command, which must be
+// sent exactly once before the first measurement after power-on. A failed
+// attempt is not remembered, so a transient i2c error at startup doesn't
+// permanently disable the sensor: the next Read retries it.
+func (s *SGP30Sensor) init() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.initDone {
+		return nil
+	}
+	if _, err := s.conn.Write(sgp30CmdInitAirQuality); err != nil {
+		return fmt.Errorf("sgp30: init air quality: %w", err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	s.initDone = true
+	return nil
+}
+
+// Read implements Sensor.
+func (s *SGP30Sensor) Read(ctx context.Context) ([]Measurement, error) {
+	if err := s.init(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.conn.Write(sgp30CmdMeasureAirQuality); err != nil {
+		return nil, fmt.Errorf("sgp30: writing measure command: %w", err)
+	}
+	time.Sleep(12 * time.Millisecond) // per datasheet: max measurement duration
+
+	buf := make([]byte, 6)
+	if _, err := s.conn.Read(buf); err != nil {
+		return nil, fmt.Errorf("sgp30: reading measurement: %w", err)
+	}
+
+	eco2, err := decodeSensirionUint16(buf[0:3])
+	if err != nil {
+		return nil, fmt.Errorf("sgp30: eco2: %w", err)
+	}
+	tvoc, err := decodeSensirionUint16(buf[3:6])
+	if err != nil {
+		return nil, fmt.Errorf("sgp30: tvoc: %w", err)
+	}
+
+	return []Measurement{
+		{Name: ECO2, Value: float64(eco2), Unit: "ppm"},
+		{Name: TVOC, Value: float64(tvoc), Unit: "ppb"},
+	}, nil
+}
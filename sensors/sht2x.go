@@ -0,0 +1,48 @@
+package sensors
+
+import (
+	"context"
+
+	"gobot.io/x/gobot/drivers/i2c"
+
+	"github.com/hnw/rpi-sensor-exporter/psychro"
+)
+
+// SHT2xSensor reads temperature and humidity from a Sensirion SHT2x via
+// gobot's i2c driver.
+type SHT2xSensor struct {
+	driver   *i2c.SHT2xDriver
+	id       string
+	location string
+}
+
+// NewSHT2xSensor wraps an already-started SHT2x driver as a Sensor.
+func NewSHT2xSensor(driver *i2c.SHT2xDriver, id, location string) *SHT2xSensor {
+	return &SHT2xSensor{driver: driver, id: id, location: location}
+}
+
+func (s *SHT2xSensor) ID() string       { return s.id }
+func (s *SHT2xSensor) Location() string { return s.location }
+func (s *SHT2xSensor) Close() error     { return s.driver.Halt() }
+
+// Read implements Sensor.
+func (s *SHT2xSensor) Read(ctx context.Context) ([]Measurement, error) {
+	t, err := s.driver.Temperature()
+	if err != nil {
+		return nil, err
+	}
+	h, err := s.driver.Humidity()
+	if err != nil {
+		return nil, err
+	}
+
+	tf, hf := float64(t), float64(h)
+	return []Measurement{
+		{Name: Temperature, Value: tf, Unit: "celsius"},
+		{Name: Humidity, Value: hf, Unit: "percent"},
+		{Name: AbsoluteHumidity, Value: psychro.AbsoluteHumidity(tf, hf), Unit: "g_m3"},
+		{Name: DewPoint, Value: psychro.DewPoint(tf, hf), Unit: "celsius"},
+		{Name: FrostPoint, Value: psychro.FrostPoint(tf, hf), Unit: "celsius"},
+		{Name: HeatIndex, Value: psychro.HeatIndex(tf, hf), Unit: "celsius"},
+	}, nil
+}
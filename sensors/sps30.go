@@ -0,0 +1,109 @@
+package sensors
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"gobot.io/x/gobot/drivers/i2c"
+)
+
+var (
+	// sps30CmdStartMeasurement requests big-endian IEEE754 float output
+	// (sub-command 0x03, format byte 0x00, plus CRC over those two bytes).
+	sps30CmdStartMeasurement = []byte{0x00, 0x10, 0x03, 0x00, 0xAC}
+	sps30CmdReadDataReady    = []byte{0x02, 0x02}
+	sps30CmdReadMeasurement  = []byte{0x03, 0x00}
+)
+
+// SPS30Sensor reads PM1.0, PM2.5 and PM10 mass concentrations from a
+// Sensirion SPS30 over i2c.
+type SPS30Sensor struct {
+	conn     i2c.Connection
+	id       string
+	location string
+
+	mu        sync.Mutex
+	startDone bool
+}
+
+// NewSPS30Sensor opens an i2c connection to an SPS30 at address/bus.
+func NewSPS30Sensor(adaptor i2c.Connector, bus, address int, id, location string) (*SPS30Sensor, error) {
+	conn, err := adaptor.GetConnection(address, bus)
+	if err != nil {
+		return nil, fmt.Errorf("sps30: opening i2c connection: %w", err)
+	}
+	return &SPS30Sensor{conn: conn, id: id, location: location}, nil
+}
+
+func (s *SPS30Sensor) ID() string       { return s.id }
+func (s *SPS30Sensor) Location() string { return s.location }
+func (s *SPS30Sensor) Close() error     { return s.conn.Close() }
+
+// start runs the chip's "Start Measurement" command, which must be sent
+// once before readings become available. A failed attempt is not
+// remembered, so a transient i2c error at startup doesn't permanently
+// disable the sensor: the next Read retries it.
+func (s *SPS30Sensor) start() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.startDone {
+		return nil
+	}
+	if _, err := s.conn.Write(sps30CmdStartMeasurement); err != nil {
+		return fmt.Errorf("sps30: start measurement: %w", err)
+	}
+	time.Sleep(20 * time.Millisecond) // per datasheet: fan spin-up
+	s.startDone = true
+	return nil
+}
+
+// Read implements Sensor.
+func (s *SPS30Sensor) Read(ctx context.Context) ([]Measurement, error) {
+	if err := s.start(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.conn.Write(sps30CmdReadDataReady); err != nil {
+		return nil, fmt.Errorf("sps30: requesting data-ready flag: %w", err)
+	}
+	ready := make([]byte, 3)
+	if _, err := s.conn.Read(ready); err != nil {
+		return nil, fmt.Errorf("sps30: reading data-ready flag: %w", err)
+	}
+	if readyVal, err := decodeSensirionUint16(ready); err != nil || readyVal == 0 {
+		if err != nil {
+			return nil, fmt.Errorf("sps30: data-ready flag: %w", err)
+		}
+		return nil, fmt.Errorf("sps30: no new measurement ready")
+	}
+
+	if _, err := s.conn.Write(sps30CmdReadMeasurement); err != nil {
+		return nil, fmt.Errorf("sps30: requesting measurement: %w", err)
+	}
+	buf := make([]byte, 60)
+	if _, err := s.conn.Read(buf); err != nil {
+		return nil, fmt.Errorf("sps30: reading measurement: %w", err)
+	}
+
+	pm1_0, err := decodeSensirionFloat32(buf[0:6])
+	if err != nil {
+		return nil, fmt.Errorf("sps30: pm1.0: %w", err)
+	}
+	pm2_5, err := decodeSensirionFloat32(buf[6:12])
+	if err != nil {
+		return nil, fmt.Errorf("sps30: pm2.5: %w", err)
+	}
+	pm10, err := decodeSensirionFloat32(buf[18:24])
+	if err != nil {
+		return nil, fmt.Errorf("sps30: pm10: %w", err)
+	}
+
+	return []Measurement{
+		{Name: PM1_0, Value: float64(pm1_0), Unit: "ug_m3"},
+		{Name: PM2_5, Value: float64(pm2_5), Unit: "ug_m3"},
+		{Name: PM10, Value: float64(pm10), Unit: "ug_m3"},
+	}, nil
+}
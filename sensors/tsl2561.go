@@ -0,0 +1,39 @@
+package sensors
+
+import (
+	"context"
+
+	"gobot.io/x/gobot/drivers/i2c"
+)
+
+// TSL2561Sensor reads illuminance from a TAOS TSL2561 via gobot's i2c
+// driver.
+type TSL2561Sensor struct {
+	driver   *i2c.TSL2561Driver
+	id       string
+	location string
+}
+
+// NewTSL2561Sensor wraps an already-started TSL2561 driver as a Sensor.
+func NewTSL2561Sensor(driver *i2c.TSL2561Driver, id, location string) *TSL2561Sensor {
+	return &TSL2561Sensor{driver: driver, id: id, location: location}
+}
+
+func (s *TSL2561Sensor) ID() string       { return s.id }
+func (s *TSL2561Sensor) Location() string { return s.location }
+func (s *TSL2561Sensor) Close() error     { return s.driver.Halt() }
+
+// Read implements Sensor.
+func (s *TSL2561Sensor) Read(ctx context.Context) ([]Measurement, error) {
+	bb, ir, err := s.driver.GetLuminocity()
+	if err != nil {
+		return nil, err
+	}
+
+	lux := s.driver.CalculateLux(bb, ir)
+	return []Measurement{
+		{Name: Illuminance, Value: float64(lux), Unit: "lux"},
+		{Name: RawBroadband, Value: float64(bb), Unit: "raw"},
+		{Name: RawInfrared, Value: float64(ir), Unit: "raw"},
+	}, nil
+}
@@ -0,0 +1,12 @@
+package sensors
+
+import "io"
+
+// UARTPort is the minimal transport a serial sensor driver needs. It is
+// satisfied by *serial.Port (github.com/tarm/serial) or any other opened
+// serial connection; opening/closing the actual device is the caller's
+// responsibility, mirroring how i2c.Connector hands drivers an already
+// addressed i2c.Connection.
+type UARTPort interface {
+	io.ReadWriter
+}
@@ -0,0 +1,56 @@
+package sinks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/api"
+
+	"github.com/hnw/rpi-sensor-exporter/sensors"
+)
+
+// InfluxDB2Config configures an InfluxDB2Sink.
+type InfluxDB2Config struct {
+	ServerURL string `yaml:"server_url"`
+	Token     string `yaml:"token"`
+	Org       string `yaml:"org"`
+	Bucket    string `yaml:"bucket"`
+}
+
+// InfluxDB2Sink writes each reading as a line-protocol point tagged by
+// device and location.
+type InfluxDB2Sink struct {
+	client influxdb2.Client
+	write  api.WriteAPIBlocking
+}
+
+// NewInfluxDB2Sink creates a client for the InfluxDB2 server described by
+// cfg. No request is made to the server until the first Publish.
+func NewInfluxDB2Sink(cfg InfluxDB2Config) *InfluxDB2Sink {
+	client := influxdb2.NewClient(cfg.ServerURL, cfg.Token)
+	return &InfluxDB2Sink{client: client, write: client.WriteAPIBlocking(cfg.Org, cfg.Bucket)}
+}
+
+// Publish implements Sink.
+func (s *InfluxDB2Sink) Publish(ctx context.Context, device, location string, readings []sensors.Measurement) error {
+	now := time.Now()
+	for _, m := range readings {
+		point := influxdb2.NewPoint(m.Name,
+			map[string]string{"device": device, "location": location},
+			map[string]interface{}{"value": m.Value},
+			now,
+		)
+		if err := s.write.WritePoint(ctx, point); err != nil {
+			return fmt.Errorf("influxdb2: writing %s: %w", m.Name, err)
+		}
+	}
+	return nil
+}
+
+// Close implements Sink.
+func (s *InfluxDB2Sink) Close() error {
+	s.client.Close()
+	return nil
+}
@@ -0,0 +1,60 @@
+package sinks
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"strconv"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+
+	"github.com/hnw/rpi-sensor-exporter/sensors"
+)
+
+// MQTTConfig configures an MQTTSink.
+type MQTTConfig struct {
+	Broker      string `yaml:"broker"`
+	TopicPrefix string `yaml:"topic_prefix"`
+	QoS         byte   `yaml:"qos"`
+	ClientID    string `yaml:"client_id"`
+	TLS         bool   `yaml:"tls"`
+}
+
+// MQTTSink publishes each measurement to
+// "<topic_prefix>/<location>/<device>/<measurement>".
+type MQTTSink struct {
+	client mqtt.Client
+	cfg    MQTTConfig
+}
+
+// NewMQTTSink connects to the broker described by cfg.
+func NewMQTTSink(cfg MQTTConfig) (*MQTTSink, error) {
+	opts := mqtt.NewClientOptions().AddBroker(cfg.Broker).SetClientID(cfg.ClientID)
+	if cfg.TLS {
+		opts.SetTLSConfig(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+
+	client := mqtt.NewClient(opts)
+	if token := client.Connect(); token.Wait() && token.Error() != nil {
+		return nil, fmt.Errorf("mqtt: connecting to %s: %w", cfg.Broker, token.Error())
+	}
+	return &MQTTSink{client: client, cfg: cfg}, nil
+}
+
+// Publish implements Sink.
+func (s *MQTTSink) Publish(ctx context.Context, device, location string, readings []sensors.Measurement) error {
+	for _, m := range readings {
+		topic := fmt.Sprintf("%s/%s/%s/%s", s.cfg.TopicPrefix, location, device, m.Name)
+		payload := strconv.FormatFloat(m.Value, 'f', -1, 64)
+		if token := s.client.Publish(topic, s.cfg.QoS, false, payload); token.Wait() && token.Error() != nil {
+			return fmt.Errorf("mqtt: publishing %s: %w", topic, token.Error())
+		}
+	}
+	return nil
+}
+
+// Close implements Sink.
+func (s *MQTTSink) Close() error {
+	s.client.Disconnect(250)
+	return nil
+}
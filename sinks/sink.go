@@ -0,0 +1,18 @@
+// Package sinks defines push destinations for sensor readings, published
+// alongside (not instead of) the Prometheus /metrics endpoint.
+package sinks
+
+import (
+	"context"
+
+	"github.com/hnw/rpi-sensor-exporter/sensors"
+)
+
+// Sink is implemented by every push destination the exporter can publish
+// readings to.
+type Sink interface {
+	// Publish sends one sensor's readings from a single scrape.
+	Publish(ctx context.Context, device, location string, readings []sensors.Measurement) error
+	// Close releases any resources held by the sink.
+	Close() error
+}
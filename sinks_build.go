@@ -0,0 +1,31 @@
+package main
+
+import (
+	"github.com/hnw/rpi-sensor-exporter/sinks"
+)
+
+// buildSinks constructs the push sinks enabled in cfg. Unlike sensors, an
+// MQTT sink that fails to connect aborts startup: a misconfigured broker is
+// a configuration error the operator should fix, not something to silently
+// run without. The InfluxDB2 sink does not contact its server here, so a
+// bad server URL or token only shows up later as publish errors.
+func buildSinks(cfg *Config) ([]sinks.Sink, error) {
+	var out []sinks.Sink
+
+	if cfg.MQTT != nil {
+		sink, err := sinks.NewMQTTSink(*cfg.MQTT)
+		if err != nil {
+			return nil, err
+		}
+		logger.Infow("mqtt sink enabled", "broker", cfg.MQTT.Broker, "topic_prefix", cfg.MQTT.TopicPrefix)
+		out = append(out, sink)
+	}
+
+	if cfg.InfluxDB2 != nil {
+		sink := sinks.NewInfluxDB2Sink(*cfg.InfluxDB2)
+		logger.Infow("influxdb2 sink enabled", "server_url", cfg.InfluxDB2.ServerURL, "bucket", cfg.InfluxDB2.Bucket)
+		out = append(out, sink)
+	}
+
+	return out, nil
+}
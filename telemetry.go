@@ -0,0 +1,56 @@
+package main
+
+import (
+	"runtime"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/hnw/rpi-sensor-exporter/version"
+)
+
+const telemetryNamespace = "rpi_sensor_exporter"
+
+// telemetry holds the exporter's self-monitoring metrics. These live on
+// their own registry, separate from the sensor collector, so that exporter
+// health stays observable even when every sensor read is failing.
+type telemetry struct {
+	scrapeDuration prometheus.Gauge
+	scrapeErrors   *prometheus.CounterVec
+	i2cReads       *prometheus.CounterVec
+}
+
+// newTelemetry builds the exporter's self-telemetry metrics and registers
+// them, along with a build_info metric, on reg.
+func newTelemetry(reg *prometheus.Registry) *telemetry {
+	t := &telemetry{
+		scrapeDuration: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: telemetryNamespace + "_scrape_duration_seconds",
+			Help: "Time spent scraping all configured sensors during the last collection",
+		}),
+		scrapeErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: telemetryNamespace + "_scrape_errors_total",
+			Help: "Number of failed sensor reads, by device",
+		}, []string{"device"}),
+		i2cReads: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: telemetryNamespace + "_i2c_reads_total",
+			Help: "Number of sensor read attempts, by device and result",
+		}, []string{"device", "result"}),
+	}
+
+	buildInfo := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: telemetryNamespace + "_build_info",
+		Help: "Build information about the running exporter binary",
+	}, []string{"version", "revision", "branch", "goversion"})
+	buildInfo.WithLabelValues(version.Version, version.Revision, version.Branch, runtime.Version()).Set(1)
+
+	reg.MustRegister(t.scrapeDuration, t.scrapeErrors, t.i2cReads, buildInfo)
+
+	// Splitting sensor metrics onto their own registry must not drop the
+	// standard Go/process metrics promhttp.Handler() used to register on
+	// prometheus.DefaultRegisterer; keep them alongside the rest of the
+	// exporter's own telemetry.
+	reg.MustRegister(prometheus.NewGoCollector())
+	reg.MustRegister(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}))
+
+	return t
+}
@@ -0,0 +1,14 @@
+// Package version holds build metadata stamped in at link time via
+// `-ldflags -X`, so the running binary can report what it actually is.
+package version
+
+// Version, Revision, and Branch are overridden at build time, e.g.:
+//
+//	go build -ldflags "-X github.com/hnw/rpi-sensor-exporter/version.Version=1.2.3 \
+//	  -X github.com/hnw/rpi-sensor-exporter/version.Revision=$(git rev-parse HEAD) \
+//	  -X github.com/hnw/rpi-sensor-exporter/version.Branch=$(git rev-parse --abbrev-ref HEAD)"
+var (
+	Version  = "dev"
+	Revision = "unknown"
+	Branch   = "unknown"
+)